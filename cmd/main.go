@@ -1,34 +1,94 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"log"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
+	"lazuli/pkg/crawler"
 	"lazuli/pkg/scraper"
+	"lazuli/pkg/sink"
+	"lazuli/pkg/sitepkg"
 )
 
+// repeatedFlag collects every occurrence of a flag passed more than once,
+// e.g. --out csv:./out.csv --out sql:mysql://....
+type repeatedFlag []string
+
+func (r *repeatedFlag) String() string { return strings.Join(*r, ",") }
+
+func (r *repeatedFlag) Set(value string) error {
+	*r = append(*r, value)
+	return nil
+}
+
 func main() {
-	log.Println("🚀 Starting Adidas product data scraping and CSV generation...")
+	if len(os.Args) > 1 && os.Args[1] == "serve" {
+		runServe(os.Args[2:])
+		return
+	}
+	runCrawl(os.Args[1:])
+}
+
+func runCrawl(args []string) {
+	fs := flag.NewFlagSet("crawl", flag.ExitOnError)
+	var outSpecs repeatedFlag
+	fs.Var(&outSpecs, "out", "output sink, e.g. csv:./out.csv, ndjson:-, sql:mysql://... (repeatable)")
+	cacheDir := fs.String("cache-dir", "", "directory of cached/replayed fetch fixtures (disabled if empty)")
+	record := fs.Bool("record", false, "force a live fetch and overwrite cached fixtures in -cache-dir")
+	replay := fs.Bool("replay", false, "serve entirely from -cache-dir, making no network calls")
+	fs.Parse(args)
+
+	if len(outSpecs) == 0 {
+		outSpecs = repeatedFlag{"ndjson:-"}
+	}
+
+	log.Println("🚀 Starting Adidas product crawl...")
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	var fetcher scraper.Fetcher
+	switch {
+	case *replay:
+		if *cacheDir == "" {
+			log.Fatal("❌ -replay requires -cache-dir")
+		}
+		fetcher = scraper.NewReplayFetcher(*cacheDir)
+	case *cacheDir != "":
+		cfg := scraper.LoadConfigFromEnv()
+		live := scraper.NewZenRowsFetcher(cfg)
+		cachingFetcher := scraper.NewCachingFetcher(live, *cacheDir, 24*time.Hour)
+		cachingFetcher.Record = *record
+		fetcher = cachingFetcher
+	default:
+		cfg := scraper.LoadConfigFromEnv()
+		fetcher = scraper.NewZenRowsFetcher(cfg)
+	}
+
+	registry, err := sitepkg.NewRegistry()
+	if err != nil {
+		log.Fatalf("❌ Failed to load site profiles: %v", err)
+	}
 
-	// Step 1: Scrape data from Adidas using ZenRows and save to sample.json
-	err := scraper.ScrapeAndSaveToJSON(scraper.SampleJSONFile)
+	out, err := sink.ParseMulti(outSpecs)
 	if err != nil {
-		log.Fatalf("❌ Failed to scrape data and save to JSON: %v", err)
+		log.Fatalf("❌ Failed to initialize output sinks: %v", err)
 	}
 
-	// Step 2: Read product data from sample.json and write to CSV
-	err = scraper.ProcessJSONAndWriteToCSV(scraper.SampleJSONFile)
+	crawl, err := crawler.New(crawler.DefaultConfig(), fetcher, registry, out)
 	if err != nil {
-		log.Fatalf("❌ Failed to process JSON and write to CSV: %v", err)
+		log.Fatalf("❌ Failed to initialize crawler: %v", err)
 	}
 
-	// Step 3: Delete the sample.json file
-	log.Printf("🗑️ Deleting temporary file: %s...", scraper.SampleJSONFile)
-	if err := os.Remove(scraper.SampleJSONFile); err != nil {
-		log.Printf("⚠️ Failed to delete %s: %v", scraper.SampleJSONFile, err)
-	} else {
-		log.Printf("✅ Successfully deleted %s\n", scraper.SampleJSONFile)
+	if err := crawl.Run(ctx, []string{scraper.AdidasMenURL}); err != nil {
+		log.Fatalf("❌ Crawl failed: %v", err)
 	}
 
-	log.Println("✅ All processes completed successfully!")
+	log.Println("✅ Crawl complete!")
 }
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+
+	"lazuli/pkg/scraper"
+	"lazuli/pkg/server"
+	"lazuli/pkg/sitepkg"
+)
+
+// runServe starts the HTTP service exposing /jobs, /products and /metrics,
+// turning the scraper into a long-running, observable service instead of a
+// one-shot CLI run.
+func runServe(args []string) {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	addr := fs.String("addr", ":8080", "address to listen on")
+	fs.Parse(args)
+
+	cfg := scraper.LoadConfigFromEnv()
+	fetcher := scraper.NewZenRowsFetcher(cfg)
+
+	registry, err := sitepkg.NewRegistry()
+	if err != nil {
+		log.Fatalf("❌ Failed to load site profiles: %v", err)
+	}
+
+	srv := server.New(fetcher, registry)
+
+	log.Printf("🚀 Serving on %s (POST /jobs, GET /jobs/{id}, GET /products, GET /metrics)...", *addr)
+	if err := http.ListenAndServe(*addr, srv.Handler()); err != nil {
+		log.Fatalf("❌ Server stopped: %v", err)
+	}
+}
@@ -0,0 +1,221 @@
+// Package crawler walks one or more seed URLs concurrently, following
+// pagination, while respecting per-host rate limits and concurrency caps. A
+// resumable journal lets an interrupted run pick back up without re-fetching
+// pages it already completed.
+package crawler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"lazuli/pkg/scraper"
+	"lazuli/pkg/sink"
+	"lazuli/pkg/sitepkg"
+)
+
+// Config controls a Crawler's concurrency, rate limiting and resume
+// behaviour.
+type Config struct {
+	// Workers is how many URLs are fetched concurrently across all hosts.
+	Workers int
+	// RatePerSecond and Burst bound how fast a single host is hit.
+	RatePerSecond float64
+	Burst         int
+	// MaxPerHost caps how many requests to one host run at once,
+	// independent of the global Workers count.
+	MaxPerHost int
+	// MaxRetries and RetryBackoff govern backoff on fetch errors
+	// (including 429/5xx responses surfaced by the underlying Fetcher).
+	MaxRetries   int
+	RetryBackoff time.Duration
+	// JournalPath is where completed URLs are recorded for resume.
+	JournalPath string
+	// Silent disables the progress bar (e.g. when embedded in a service).
+	Silent bool
+}
+
+// DefaultConfig returns reasonable defaults for a polite, resumable crawl.
+func DefaultConfig() Config {
+	return Config{
+		Workers:       4,
+		RatePerSecond: 1,
+		Burst:         2,
+		MaxPerHost:    2,
+		MaxRetries:    3,
+		RetryBackoff:  time.Second,
+		JournalPath:   "crawl.journal",
+	}
+}
+
+// Crawler fetches a set of seed URLs and everything reachable from them via
+// pagination, streaming extracted products out as newline-delimited JSON.
+type Crawler struct {
+	cfg      Config
+	fetcher  scraper.Fetcher
+	registry *sitepkg.Registry
+	out      sink.Sink
+	outMu    sync.Mutex // Sink implementations aren't required to be goroutine-safe
+	limiter  *hostLimiter
+	journal  *Journal
+}
+
+// New builds a Crawler. Extracted products are written to out as the crawl
+// progresses, rather than buffered; out is closed when Run returns.
+func New(cfg Config, fetcher scraper.Fetcher, registry *sitepkg.Registry, out sink.Sink) (*Crawler, error) {
+	journal, err := OpenJournal(cfg.JournalPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Crawler{
+		cfg:      cfg,
+		fetcher:  fetcher,
+		registry: registry,
+		out:      out,
+		limiter:  newHostLimiter(cfg.RatePerSecond, cfg.Burst, cfg.MaxPerHost),
+		journal:  journal,
+	}, nil
+}
+
+// Run crawls seeds to completion, or until ctx is cancelled (e.g. on
+// SIGINT). Seeds already recorded in the journal from a prior run are
+// skipped.
+func (c *Crawler) Run(ctx context.Context, seeds []string) error {
+	defer c.journal.Close()
+	defer c.out.Close()
+
+	queue := newURLQueue(ctx.Done())
+	pending := 0
+	for _, seed := range seeds {
+		if !c.journal.IsDone(seed) {
+			queue.push(seed)
+			pending++
+		}
+	}
+
+	prog := newProgress(pending, c.cfg.Silent)
+	defer prog.finish()
+
+	cancelled := func() bool { return ctx.Err() != nil }
+
+	workers := c.cfg.Workers
+	if workers < 1 {
+		workers = 1
+	}
+
+	done := make(chan struct{})
+	for i := 0; i < workers; i++ {
+		go func() {
+			for {
+				pageURL, ok := queue.pop(cancelled)
+				if !ok {
+					done <- struct{}{}
+					return
+				}
+				if err := c.crawlOne(ctx, pageURL, queue, prog); err != nil && !cancelled() {
+					log.Printf("⚠️ Failed to crawl %s: %v", pageURL, err)
+				}
+				queue.release()
+			}
+		}()
+	}
+	for i := 0; i < workers; i++ {
+		<-done
+	}
+
+	return ctx.Err()
+}
+
+func (c *Crawler) crawlOne(ctx context.Context, pageURL string, queue *urlQueue, prog *progress) error {
+	defer prog.increment()
+
+	if c.journal.IsDone(pageURL) {
+		return nil
+	}
+
+	release, err := c.limiter.Acquire(ctx, pageURL)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	profile, hasProfile := c.registry.ForURL(pageURL)
+
+	target := scraper.Target{URL: pageURL}
+	if hasProfile {
+		target = scraper.TargetForProfile(pageURL, profile)
+	}
+
+	result, err := c.fetchWithRetry(ctx, target)
+	if err != nil {
+		return err
+	}
+
+	if hasProfile {
+		if err := c.extractAndWrite(profile, result); err != nil {
+			log.Printf("⚠️ Failed to extract products from %s: %v", pageURL, err)
+		}
+
+		if next, ok := sitepkg.NextPageURL(profile, result.HTML, pageURL); ok && !c.journal.IsDone(next) {
+			queue.push(next)
+			prog.addTotal(1)
+		}
+	}
+
+	return c.journal.MarkDone(pageURL)
+}
+
+func (c *Crawler) extractAndWrite(profile *sitepkg.Profile, result *scraper.PageResult) error {
+	for _, xhr := range result.XHR {
+		if !strings.Contains(xhr.URL, profile.XHRURLContains) {
+			continue
+		}
+
+		products, _, err := sitepkg.Extract(profile, xhr.Body, result.HTML)
+		if err != nil {
+			return err
+		}
+
+		for _, record := range products {
+			if err := c.writeProduct(scraper.ProductFromRecord(record)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
+func (c *Crawler) writeProduct(product scraper.ProductDetails) error {
+	c.outMu.Lock()
+	defer c.outMu.Unlock()
+	return c.out.Write(product)
+}
+
+func (c *Crawler) fetchWithRetry(ctx context.Context, target scraper.Target) (*scraper.PageResult, error) {
+	var lastErr error
+
+	for attempt := 0; attempt <= c.cfg.MaxRetries; attempt++ {
+		if attempt > 0 {
+			backoff := time.Duration(float64(c.cfg.RetryBackoff) * math.Pow(2, float64(attempt-1)))
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(backoff):
+			}
+		}
+
+		result, err := c.fetcher.Fetch(ctx, target)
+		if err == nil {
+			return result, nil
+		}
+		lastErr = err
+	}
+
+	return nil, fmt.Errorf("crawler: giving up on %s after %d retries: %w", target.URL, c.cfg.MaxRetries, lastErr)
+}
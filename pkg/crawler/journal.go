@@ -0,0 +1,88 @@
+package crawler
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// Journal records which URLs have already been crawled so an interrupted
+// run can resume without re-fetching them. It is append-only: each
+// completed URL is written as one JSON line, and the whole file is replayed
+// into memory on load.
+type Journal struct {
+	mu   sync.Mutex
+	path string
+	file *os.File
+	done map[string]bool
+}
+
+type journalEntry struct {
+	URL string `json:"url"`
+}
+
+// OpenJournal loads an existing journal from path, creating it if it
+// doesn't exist yet.
+func OpenJournal(path string) (*Journal, error) {
+	j := &Journal{path: path, done: make(map[string]bool)}
+
+	if existing, err := os.Open(path); err == nil {
+		scanner := bufio.NewScanner(existing)
+		for scanner.Scan() {
+			var entry journalEntry
+			if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+				continue // tolerate a partially-written last line from a crash
+			}
+			j.done[entry.URL] = true
+		}
+		existing.Close()
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("crawler: failed to read journal %s: %w", path, err)
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("crawler: failed to open journal %s: %w", path, err)
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("crawler: failed to open journal %s for append: %w", path, err)
+	}
+	j.file = file
+
+	return j, nil
+}
+
+// IsDone reports whether url was already marked complete in a prior run.
+func (j *Journal) IsDone(url string) bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.done[url]
+}
+
+// MarkDone records url as complete so a future resume skips it.
+func (j *Journal) MarkDone(url string) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.done[url] {
+		return nil
+	}
+
+	line, err := json.Marshal(journalEntry{URL: url})
+	if err != nil {
+		return fmt.Errorf("crawler: failed to encode journal entry: %w", err)
+	}
+	if _, err := j.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("crawler: failed to append journal entry: %w", err)
+	}
+
+	j.done[url] = true
+	return nil
+}
+
+// Close flushes and closes the underlying journal file.
+func (j *Journal) Close() error {
+	return j.file.Close()
+}
@@ -0,0 +1,39 @@
+package crawler
+
+import (
+	"github.com/cheggaaa/pb/v3"
+)
+
+// progress wraps a pb progress bar tracking pages crawled. It's a no-op
+// when silent is true, so callers embedding the crawler in another service
+// aren't forced to print to stdout.
+type progress struct {
+	bar *pb.ProgressBar
+}
+
+func newProgress(total int, silent bool) *progress {
+	if silent || total <= 0 {
+		return &progress{}
+	}
+	bar := pb.StartNew(total)
+	bar.SetTemplateString(`{{counters . }} pages {{bar . }} {{percent . }} {{etime . }}`)
+	return &progress{bar: bar}
+}
+
+func (p *progress) increment() {
+	if p.bar != nil {
+		p.bar.Increment()
+	}
+}
+
+func (p *progress) addTotal(n int) {
+	if p.bar != nil {
+		p.bar.SetTotal(p.bar.Total() + int64(n))
+	}
+}
+
+func (p *progress) finish() {
+	if p.bar != nil {
+		p.bar.Finish()
+	}
+}
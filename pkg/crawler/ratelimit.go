@@ -0,0 +1,82 @@
+package crawler
+
+import (
+	"context"
+	"net/url"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// hostLimiter hands out a token-bucket rate limiter and a concurrency
+// semaphore per host, so one slow or strict host can't starve the others.
+type hostLimiter struct {
+	mu            sync.Mutex
+	limiters      map[string]*rate.Limiter
+	semaphores    map[string]chan struct{}
+	ratePerSecond float64
+	burst         int
+	maxPerHost    int
+}
+
+func newHostLimiter(ratePerSecond float64, burst, maxPerHost int) *hostLimiter {
+	return &hostLimiter{
+		limiters:      make(map[string]*rate.Limiter),
+		semaphores:    make(map[string]chan struct{}),
+		ratePerSecond: ratePerSecond,
+		burst:         burst,
+		maxPerHost:    maxPerHost,
+	}
+}
+
+func (h *hostLimiter) hostFor(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	return parsed.Host
+}
+
+func (h *hostLimiter) limiterFor(host string) *rate.Limiter {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	limiter, ok := h.limiters[host]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(h.ratePerSecond), h.burst)
+		h.limiters[host] = limiter
+	}
+	return limiter
+}
+
+func (h *hostLimiter) semaphoreFor(host string) chan struct{} {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	sem, ok := h.semaphores[host]
+	if !ok {
+		sem = make(chan struct{}, h.maxPerHost)
+		h.semaphores[host] = sem
+	}
+	return sem
+}
+
+// Acquire blocks until both the rate limiter and the per-host concurrency
+// cap allow rawURL's host to be fetched. The returned release func must be
+// called once the fetch completes.
+func (h *hostLimiter) Acquire(ctx context.Context, rawURL string) (release func(), err error) {
+	host := h.hostFor(rawURL)
+
+	if err := h.limiterFor(host).Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	sem := h.semaphoreFor(host)
+	select {
+	case sem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	return func() { <-sem }, nil
+}
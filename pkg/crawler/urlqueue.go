@@ -0,0 +1,69 @@
+package crawler
+
+import "sync"
+
+// urlQueue is an unbounded FIFO of pending URLs that also tracks how many
+// are currently being processed, so pop can tell "temporarily empty" apart
+// from "nothing left anywhere" and return accordingly.
+type urlQueue struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	items  []string
+	active int
+}
+
+func newURLQueue(done <-chan struct{}) *urlQueue {
+	q := &urlQueue{}
+	q.cond = sync.NewCond(&q.mu)
+
+	go func() {
+		<-done
+		q.mu.Lock()
+		q.cond.Broadcast()
+		q.mu.Unlock()
+	}()
+
+	return q
+}
+
+func (q *urlQueue) push(url string) {
+	q.mu.Lock()
+	q.items = append(q.items, url)
+	q.cond.Broadcast()
+	q.mu.Unlock()
+}
+
+// pop blocks until a URL is available, the queue is fully drained (nothing
+// queued and nothing in flight), or done (passed to newURLQueue) fires.
+func (q *urlQueue) pop(cancelled func() bool) (string, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for {
+		if len(q.items) > 0 {
+			url := q.items[0]
+			q.items = q.items[1:]
+			q.active++
+			return url, true
+		}
+		if q.active == 0 || cancelled() {
+			return "", false
+		}
+		q.cond.Wait()
+	}
+}
+
+// release marks one previously popped item as finished and wakes any
+// worker that might now see the queue as fully drained.
+func (q *urlQueue) release() {
+	q.mu.Lock()
+	q.active--
+	q.cond.Broadcast()
+	q.mu.Unlock()
+}
+
+func (q *urlQueue) len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.items)
+}
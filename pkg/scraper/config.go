@@ -0,0 +1,19 @@
+package scraper
+
+import "os"
+
+// Config holds the settings needed to talk to external fetch backends.
+// Values are sourced from the environment so secrets like API keys never
+// live in source control.
+type Config struct {
+	// ZenRowsAPIKey authenticates requests made through the ZenRows fetcher.
+	ZenRowsAPIKey string
+}
+
+// LoadConfigFromEnv builds a Config from environment variables, using the
+// defaults ZenRows itself documents when a variable is unset.
+func LoadConfigFromEnv() Config {
+	return Config{
+		ZenRowsAPIKey: os.Getenv("ZENROWS_API_KEY"),
+	}
+}
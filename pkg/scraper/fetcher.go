@@ -0,0 +1,60 @@
+package scraper
+
+import (
+	"context"
+	"net/http"
+
+	"lazuli/pkg/sitepkg"
+)
+
+// Target describes a single page to fetch and the interactions needed to
+// reach the data on it. The same Target drives every Fetcher implementation,
+// so a job spec can be replayed against ZenRows, plain HTTP or a headless
+// browser without change.
+type Target struct {
+	// URL is the page to load.
+	URL string
+	// WaitForSelector, if set, blocks until the selector appears before the
+	// page is considered ready (e.g. lazy-loaded product grids).
+	WaitForSelector string
+	// ClickSelector, if set, is clicked once the page loads (e.g. to dismiss
+	// a cookie banner or open an infinite-scroll section).
+	ClickSelector string
+	// FillSelector/FillValue, if both set, fill an input before continuing.
+	FillSelector string
+	FillValue    string
+	// Cookies are attached to the request/session before navigation.
+	Cookies []*http.Cookie
+}
+
+// TargetForProfile builds the Target for pageURL, carrying over profile's
+// declared interactions so a browser-backed Fetcher can reach data that only
+// appears after a click, a form fill or an async render.
+func TargetForProfile(pageURL string, profile *sitepkg.Profile) Target {
+	target := Target{
+		URL:             pageURL,
+		WaitForSelector: profile.WaitForSelector,
+		ClickSelector:   profile.ClickSelector,
+		FillSelector:    profile.FillSelector,
+		FillValue:       profile.FillValue,
+	}
+	for _, c := range profile.Cookies {
+		target.Cookies = append(target.Cookies, &http.Cookie{Name: c.Name, Value: c.Value})
+	}
+	return target
+}
+
+// PageResult is what every Fetcher implementation returns: the rendered HTML
+// plus any XHR responses observed while loading the page.
+type PageResult struct {
+	HTML       string
+	XHR        []XHRResponse
+	StatusCode int
+}
+
+// Fetcher retrieves a Target's page and any XHR traffic it triggers. Each
+// implementation trades off cost, JS support and infrastructure differently;
+// callers pick one based on what the target site requires.
+type Fetcher interface {
+	Fetch(ctx context.Context, target Target) (*PageResult, error)
+}
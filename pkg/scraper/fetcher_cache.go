@@ -0,0 +1,102 @@
+package scraper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// CachingFetcher sits in front of another Fetcher and stores its raw
+// results on disk, keyed by a hash of the Target. This lets the pipeline be
+// unit-tested against captured fixtures without hitting the network or
+// burning paid-API credits on every run.
+type CachingFetcher struct {
+	underlying Fetcher
+	dir        string
+	ttl        time.Duration
+	// Record forces a live fetch on every call, overwriting any cached
+	// fixture, instead of serving a fresh-enough cache hit.
+	Record bool
+}
+
+// NewCachingFetcher wraps underlying with a disk cache rooted at dir.
+// Entries older than ttl are treated as a miss and re-fetched; ttl <= 0
+// means cached entries never expire.
+func NewCachingFetcher(underlying Fetcher, dir string, ttl time.Duration) *CachingFetcher {
+	return &CachingFetcher{underlying: underlying, dir: dir, ttl: ttl}
+}
+
+type cacheEntry struct {
+	FetchedAt time.Time  `json:"fetchedAt"`
+	Result    PageResult `json:"result"`
+}
+
+// Fetch implements Fetcher.
+func (f *CachingFetcher) Fetch(ctx context.Context, target Target) (*PageResult, error) {
+	path := f.pathFor(target)
+
+	if !f.Record {
+		if entry, ok := f.readEntry(path); ok && !f.expired(entry.FetchedAt) {
+			return &entry.Result, nil
+		}
+	}
+
+	result, err := f.underlying.Fetch(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := f.writeEntry(path, result); err != nil {
+		return nil, fmt.Errorf("cache: failed to write fixture for %s: %w", target.URL, err)
+	}
+
+	return result, nil
+}
+
+func (f *CachingFetcher) expired(fetchedAt time.Time) bool {
+	return f.ttl > 0 && time.Since(fetchedAt) > f.ttl
+}
+
+func (f *CachingFetcher) readEntry(path string) (*cacheEntry, bool) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return nil, false
+	}
+	return &entry, true
+}
+
+func (f *CachingFetcher) writeEntry(path string, result *PageResult) error {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	raw, err := json.Marshal(cacheEntry{FetchedAt: time.Now(), Result: *result})
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, raw, 0o644)
+}
+
+func (f *CachingFetcher) pathFor(target Target) string {
+	return filepath.Join(f.dir, cacheKey(target)+".json")
+}
+
+// cacheKey hashes everything that affects what's fetched: the URL and the
+// JS instructions/cookies that drive it. Two Targets that resolve to the
+// same page share a cache entry even if constructed separately.
+func cacheKey(target Target) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\n%s\n%s\n%s\n%s\n", target.URL, target.WaitForSelector, target.ClickSelector, target.FillSelector, target.FillValue)
+	for _, cookie := range target.Cookies {
+		fmt.Fprintf(h, "%s=%s\n", cookie.Name, cookie.Value)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
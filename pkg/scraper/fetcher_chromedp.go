@@ -0,0 +1,129 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/chromedp/cdproto/network"
+	"github.com/chromedp/chromedp"
+)
+
+// ChromedpFetcherConfig configures the headless Chromium instance used by
+// ChromedpFetcher.
+type ChromedpFetcherConfig struct {
+	// Headless runs Chromium without a visible window. Defaults to true.
+	Headless bool
+	// Navigation/wait timeout for a single Target.
+	Timeout time.Duration
+}
+
+// DefaultChromedpFetcherConfig returns sane defaults for headless scraping.
+func DefaultChromedpFetcherConfig() ChromedpFetcherConfig {
+	return ChromedpFetcherConfig{
+		Headless: true,
+		Timeout:  45 * time.Second,
+	}
+}
+
+// ChromedpFetcher renders pages in a real Chromium instance via chromedp, so
+// JS-only sites work without a paid third-party rendering API. It records
+// XHR bodies through the DevTools Network domain as they cross the wire.
+type ChromedpFetcher struct {
+	cfg ChromedpFetcherConfig
+}
+
+// NewChromedpFetcher builds a ChromedpFetcher from cfg.
+func NewChromedpFetcher(cfg ChromedpFetcherConfig) *ChromedpFetcher {
+	return &ChromedpFetcher{cfg: cfg}
+}
+
+// Fetch implements Fetcher.
+func (f *ChromedpFetcher) Fetch(ctx context.Context, target Target) (*PageResult, error) {
+	allocatorOpts := chromedp.DefaultExecAllocatorOptions[:]
+	if !f.cfg.Headless {
+		allocatorOpts = append(allocatorOpts, chromedp.Flag("headless", false))
+	}
+
+	allocCtx, cancelAlloc := chromedp.NewExecAllocator(ctx, allocatorOpts...)
+	defer cancelAlloc()
+
+	browserCtx, cancelBrowser := chromedp.NewContext(allocCtx)
+	defer cancelBrowser()
+
+	if f.cfg.Timeout > 0 {
+		var cancelTimeout context.CancelFunc
+		browserCtx, cancelTimeout = context.WithTimeout(browserCtx, f.cfg.Timeout)
+		defer cancelTimeout()
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var xhr []XHRResponse
+	requestIDs := make(map[network.RequestID]string)
+
+	chromedp.ListenTarget(browserCtx, func(ev interface{}) {
+		switch e := ev.(type) {
+		case *network.EventRequestWillBeSent:
+			if e.Type == network.ResourceTypeXHR || e.Type == network.ResourceTypeFetch {
+				mu.Lock()
+				requestIDs[e.RequestID] = e.Request.URL
+				mu.Unlock()
+			}
+		case *network.EventLoadingFinished:
+			mu.Lock()
+			reqURL, tracked := requestIDs[e.RequestID]
+			mu.Unlock()
+			if !tracked {
+				return
+			}
+			wg.Add(1)
+			go func(id network.RequestID, url string) {
+				defer wg.Done()
+				body, err := network.GetResponseBody(id).Do(browserCtx)
+				if err != nil {
+					return
+				}
+				mu.Lock()
+				xhr = append(xhr, XHRResponse{URL: url, Body: string(body)})
+				mu.Unlock()
+			}(e.RequestID, reqURL)
+		}
+	})
+
+	actions := []chromedp.Action{
+		network.Enable(),
+		chromedp.Navigate(target.URL),
+	}
+	if target.ClickSelector != "" {
+		actions = append(actions, chromedp.Click(target.ClickSelector, chromedp.NodeVisible))
+	}
+	if target.FillSelector != "" {
+		actions = append(actions, chromedp.SendKeys(target.FillSelector, target.FillValue))
+	}
+	if target.WaitForSelector != "" {
+		actions = append(actions, chromedp.WaitVisible(target.WaitForSelector))
+	}
+
+	var html string
+	actions = append(actions, chromedp.OuterHTML("html", &html))
+
+	if err := chromedp.Run(browserCtx, actions...); err != nil {
+		return nil, fmt.Errorf("chromedp: failed to render %s: %w", target.URL, err)
+	}
+
+	// Loading-finished events can still be in flight right after Run returns;
+	// wait for every response-body read that was actually started, rather
+	// than guessing how long that takes. Each read is bounded by browserCtx
+	// (cfg.Timeout / the caller's ctx), so this can't hang past that.
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	return &PageResult{
+		HTML:       html,
+		XHR:        xhr,
+		StatusCode: 200,
+	}, nil
+}
@@ -0,0 +1,166 @@
+package scraper
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"time"
+)
+
+// HTTPFetcherConfig configures HTTPFetcher's transport and retry behaviour.
+type HTTPFetcherConfig struct {
+	// UserAgent is sent on every request. Defaults to a generic desktop UA.
+	UserAgent string
+	// InsecureSkipVerify disables TLS certificate verification. Only useful
+	// against self-signed staging environments.
+	InsecureSkipVerify bool
+	// FollowRedirects controls whether the client follows 3xx responses.
+	FollowRedirects bool
+	// MaxRetries is the number of additional attempts made on a 429/5xx
+	// response or transport error before giving up.
+	MaxRetries int
+	// RetryBackoff is the base delay used for exponential backoff between
+	// retries (attempt N waits RetryBackoff * 2^N).
+	RetryBackoff time.Duration
+	// Timeout bounds a single attempt, including redirects.
+	Timeout time.Duration
+}
+
+// DefaultHTTPFetcherConfig returns sane defaults for scraping public pages.
+func DefaultHTTPFetcherConfig() HTTPFetcherConfig {
+	return HTTPFetcherConfig{
+		UserAgent:       "Mozilla/5.0 (compatible; lazuli-scraper/1.0)",
+		FollowRedirects: true,
+		MaxRetries:      3,
+		RetryBackoff:    500 * time.Millisecond,
+		Timeout:         30 * time.Second,
+	}
+}
+
+// HTTPFetcher fetches pages with a plain net/http client. It does not render
+// JavaScript, so it only sees markup and XHRs present in the initial
+// response; use ChromedpFetcher for JS-only sites.
+type HTTPFetcher struct {
+	cfg    HTTPFetcherConfig
+	client *http.Client
+}
+
+// NewHTTPFetcher builds an HTTPFetcher from cfg, wiring up the transport and
+// redirect policy it describes.
+func NewHTTPFetcher(cfg HTTPFetcherConfig) *HTTPFetcher {
+	transport := &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: cfg.InsecureSkipVerify},
+	}
+
+	client := &http.Client{
+		Transport: transport,
+		Timeout:   cfg.Timeout,
+	}
+	if !cfg.FollowRedirects {
+		client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+	}
+
+	return &HTTPFetcher{cfg: cfg, client: client}
+}
+
+// Fetch implements Fetcher. It does not act on target.WaitForSelector,
+// target.ClickSelector or target.FillSelector since there is no JS engine to
+// drive; those fields are only meaningful to browser-backed fetchers.
+func (f *HTTPFetcher) Fetch(ctx context.Context, target Target) (*PageResult, error) {
+	var lastErr error
+
+	for attempt := 0; attempt <= f.cfg.MaxRetries; attempt++ {
+		if attempt > 0 {
+			backoff := time.Duration(float64(f.cfg.RetryBackoff) * math.Pow(2, float64(attempt-1)))
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(backoff):
+			}
+		}
+
+		result, err := f.doOnce(ctx, target)
+		if err == nil {
+			return result, nil
+		}
+		lastErr = err
+
+		if !isRetryable(err) {
+			return nil, err
+		}
+	}
+
+	return nil, fmt.Errorf("http: giving up after %d retries: %w", f.cfg.MaxRetries, lastErr)
+}
+
+type retryableStatusError struct {
+	status string
+}
+
+func (e *retryableStatusError) Error() string {
+	return fmt.Sprintf("http: retryable status: %s", e.status)
+}
+
+// transportError wraps a failure from the underlying http.Client.Do call
+// (connection reset, timeout, DNS failure, ...). These are transient in the
+// same way a 429/5xx is, so they're retried too.
+type transportError struct {
+	err error
+}
+
+func (e *transportError) Error() string {
+	return fmt.Sprintf("http: request failed: %v", e.err)
+}
+
+func (e *transportError) Unwrap() error {
+	return e.err
+}
+
+func isRetryable(err error) bool {
+	switch err.(type) {
+	case *retryableStatusError, *transportError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (f *HTTPFetcher) doOnce(ctx context.Context, target Target) (*PageResult, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("http: failed to create request: %w", err)
+	}
+	if f.cfg.UserAgent != "" {
+		req.Header.Set("User-Agent", f.cfg.UserAgent)
+	}
+	for _, cookie := range target.Cookies {
+		req.AddCookie(cookie)
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, &transportError{err: err}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+		return nil, &retryableStatusError{status: resp.Status}
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("http: failed to read response body: %w", err)
+	}
+
+	// A plain HTTP fetcher cannot see XHR traffic; the caller must rely on
+	// data embedded in the initial HTML, or switch to ChromedpFetcher.
+	return &PageResult{
+		HTML:       string(body),
+		StatusCode: resp.StatusCode,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+)
+
+// ReplayFetcher serves Targets entirely from a directory of fixtures
+// previously captured by CachingFetcher, making no network calls. It's
+// meant for deterministic tests: point it at a fixtures directory checked
+// into the repo and the whole pipeline runs offline.
+type ReplayFetcher struct {
+	dir string
+}
+
+// NewReplayFetcher returns a Fetcher that only ever reads fixtures from dir.
+func NewReplayFetcher(dir string) *ReplayFetcher {
+	return &ReplayFetcher{dir: dir}
+}
+
+// Fetch implements Fetcher. It never calls out to the network; a missing
+// fixture is an error rather than a fallback fetch.
+func (f *ReplayFetcher) Fetch(ctx context.Context, target Target) (*PageResult, error) {
+	cache := &CachingFetcher{dir: f.dir}
+	path := cache.pathFor(target)
+
+	entry, ok := cache.readEntry(path)
+	if !ok {
+		return nil, fmt.Errorf("replay: no fixture found for %s in %s (run with --record first)", target.URL, f.dir)
+	}
+
+	return &entry.Result, nil
+}
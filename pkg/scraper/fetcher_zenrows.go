@@ -0,0 +1,107 @@
+package scraper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// ZenRowsFetcher fetches pages through the ZenRows rendering API. It costs a
+// credit per request but handles JS rendering, proxying and anti-bot bypass
+// without any local browser infrastructure.
+type ZenRowsFetcher struct {
+	APIKey       string
+	ProxyCountry string
+	PremiumProxy bool
+	httpClient   *http.Client
+}
+
+// NewZenRowsFetcher builds a ZenRowsFetcher from the given config.
+func NewZenRowsFetcher(cfg Config) *ZenRowsFetcher {
+	return &ZenRowsFetcher{
+		APIKey:       cfg.ZenRowsAPIKey,
+		ProxyCountry: "us",
+		PremiumProxy: true,
+		httpClient:   &http.Client{},
+	}
+}
+
+type zenRowsJSInstruction map[string]interface{}
+
+func (f *ZenRowsFetcher) instructionsFor(target Target) []zenRowsJSInstruction {
+	var instructions []zenRowsJSInstruction
+	if target.ClickSelector != "" {
+		instructions = append(instructions, zenRowsJSInstruction{"click": target.ClickSelector})
+	}
+	if target.FillSelector != "" {
+		instructions = append(instructions, zenRowsJSInstruction{"fill": []string{target.FillSelector, target.FillValue}})
+	}
+	if target.WaitForSelector != "" {
+		instructions = append(instructions, zenRowsJSInstruction{"wait_for": target.WaitForSelector})
+	}
+	return instructions
+}
+
+// Fetch implements Fetcher.
+func (f *ZenRowsFetcher) Fetch(ctx context.Context, target Target) (*PageResult, error) {
+	if f.APIKey == "" {
+		return nil, fmt.Errorf("zenrows: no API key configured (set ZENROWS_API_KEY)")
+	}
+
+	query := url.Values{}
+	query.Set("apikey", f.APIKey)
+	query.Set("url", target.URL)
+	query.Set("js_render", "true")
+	query.Set("json_response", "true")
+	query.Set("premium_proxy", fmt.Sprintf("%t", f.PremiumProxy))
+	if f.ProxyCountry != "" {
+		query.Set("proxy_country", f.ProxyCountry)
+	}
+
+	if instructions := f.instructionsFor(target); len(instructions) > 0 {
+		encoded, err := json.Marshal(instructions)
+		if err != nil {
+			return nil, fmt.Errorf("zenrows: failed to encode js_instructions: %w", err)
+		}
+		query.Set("js_instructions", string(encoded))
+	}
+
+	zenRowsURL := "https://api.zenrows.com/v1/?" + query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, zenRowsURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("zenrows: failed to create HTTP request: %w", err)
+	}
+	for _, cookie := range target.Cookies {
+		req.AddCookie(cookie)
+	}
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("zenrows: failed to make HTTP request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("zenrows: failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("zenrows: API returned non-OK status: %s", resp.Status)
+	}
+
+	var parsed ZenRowsOverallResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, fmt.Errorf("zenrows: failed to unmarshal response: %w", err)
+	}
+
+	return &PageResult{
+		HTML:       parsed.HTML,
+		XHR:        parsed.XHR,
+		StatusCode: resp.StatusCode,
+	}, nil
+}
@@ -0,0 +1,81 @@
+package scraper
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"lazuli/pkg/sitepkg"
+)
+
+// TestReplayPipeline exercises the whole capture/replay path that
+// ReplayFetcher exists for: a Target is "fetched" from a fixture checked
+// into testdata, saved back out via ScrapeAndSaveToJSON, then read and
+// extracted via GetProductDataFromFile — with no network access anywhere.
+func TestReplayPipeline(t *testing.T) {
+	target := Target{URL: "https://testshop.example.com/products"}
+
+	fixtureDir := t.TempDir()
+	fixture, err := os.ReadFile(filepath.Join("testdata", "testshop_products.json"))
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+	cache := &CachingFetcher{dir: fixtureDir}
+	if err := os.WriteFile(cache.pathFor(target), fixture, 0o644); err != nil {
+		t.Fatalf("failed to stage fixture: %v", err)
+	}
+
+	registry, err := sitepkg.NewRegistry()
+	if err != nil {
+		t.Fatalf("failed to build registry: %v", err)
+	}
+	registry.Add(&sitepkg.Profile{
+		Domain:         "testshop.example.com",
+		XHRURLContains: "api/products",
+		ListPath:       "items",
+		Fields: map[string]sitepkg.FieldSpec{
+			"articleNumber": {JSONPath: "sku"},
+			"name":          {JSONPath: "title"},
+			"link":          {JSONPath: "url", Transforms: []string{"urljoin:https://testshop.example.com"}},
+			"currentPrice":  {JSONPath: "price"},
+		},
+	})
+
+	fetcher := NewReplayFetcher(fixtureDir)
+	captureFile := filepath.Join(t.TempDir(), "capture.json")
+	if err := ScrapeAndSaveToJSON(context.Background(), fetcher, target, captureFile); err != nil {
+		t.Fatalf("ScrapeAndSaveToJSON failed: %v", err)
+	}
+
+	products, _, err := GetProductDataFromFile(captureFile, target.URL, registry)
+	if err != nil {
+		t.Fatalf("GetProductDataFromFile failed: %v", err)
+	}
+	if len(products) != 1 {
+		t.Fatalf("got %d products, want 1", len(products))
+	}
+
+	got := products[0]
+	if got.ArticleNumber != "ABC123" {
+		t.Errorf("ArticleNumber = %q, want %q", got.ArticleNumber, "ABC123")
+	}
+	if got.Name != "Test Shoe" {
+		t.Errorf("Name = %q, want %q", got.Name, "Test Shoe")
+	}
+	if got.Link != "https://testshop.example.com/p/abc123" {
+		t.Errorf("Link = %q, want %q", got.Link, "https://testshop.example.com/p/abc123")
+	}
+	if got.Pricing.CurrentPrice != 99.99 {
+		t.Errorf("CurrentPrice = %v, want %v", got.Pricing.CurrentPrice, 99.99)
+	}
+}
+
+// TestReplayFetcherMissingFixture confirms a missing fixture is reported as
+// an error rather than falling back to a live fetch.
+func TestReplayFetcherMissingFixture(t *testing.T) {
+	fetcher := NewReplayFetcher(t.TempDir())
+	if _, err := fetcher.Fetch(context.Background(), Target{URL: "https://unknown.example.com/"}); err == nil {
+		t.Fatal("expected an error for a missing fixture, got nil")
+	}
+}
@@ -0,0 +1,30 @@
+package server
+
+import (
+	"time"
+
+	"lazuli/pkg/scraper"
+)
+
+// Status is the lifecycle state of a Job.
+type Status string
+
+const (
+	StatusPending Status = "pending"
+	StatusRunning Status = "running"
+	StatusDone    Status = "done"
+	StatusFailed  Status = "failed"
+)
+
+// Job is one submitted scrape: a URL to fetch, extracted via the site
+// profile registered for its domain, optionally written to a Sink.
+type Job struct {
+	ID        string                   `json:"id"`
+	URL       string                   `json:"url"`
+	SinkSpec  string                   `json:"sinkSpec,omitempty"`
+	Status    Status                   `json:"status"`
+	Error     string                   `json:"error,omitempty"`
+	Products  []scraper.ProductDetails `json:"products,omitempty"`
+	CreatedAt time.Time                `json:"createdAt"`
+	UpdatedAt time.Time                `json:"updatedAt"`
+}
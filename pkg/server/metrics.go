@@ -0,0 +1,34 @@
+package server
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	jobsInFlight = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "lazuli_jobs_in_flight",
+		Help: "Number of scrape jobs currently running.",
+	})
+
+	fetchLatencySeconds = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "lazuli_fetch_latency_seconds",
+		Help:    "Time spent fetching a single job's target URL.",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	jobsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "lazuli_jobs_total",
+		Help: "Number of scrape jobs completed, labelled by outcome.",
+	}, []string{"status"})
+
+	productsExtractedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "lazuli_products_extracted_total",
+		Help: "Total number of products extracted across all jobs.",
+	})
+
+	fetchRetriesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "lazuli_fetch_retries_total",
+		Help: "Total number of fetch retries performed after a 429/5xx or transport error.",
+	})
+)
@@ -0,0 +1,234 @@
+// Package server exposes the scraper as a long-running HTTP service: submit
+// scrape jobs, poll their status/results, and scrape Prometheus metrics for
+// observability, instead of driving everything from a one-shot CLI run.
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"lazuli/pkg/scraper"
+	"lazuli/pkg/sink"
+	"lazuli/pkg/sitepkg"
+)
+
+// Server holds the shared state HTTP handlers need: a fetcher for new jobs,
+// the site profile registry, and the in-memory job store.
+type Server struct {
+	fetcher  scraper.Fetcher
+	registry *sitepkg.Registry
+	store    *store
+}
+
+// New builds a Server backed by fetcher and registry.
+func New(fetcher scraper.Fetcher, registry *sitepkg.Registry) *Server {
+	return &Server{fetcher: fetcher, registry: registry, store: newStore()}
+}
+
+// Handler returns the http.Handler serving /jobs, /products and /metrics.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/jobs", s.handleJobs)
+	mux.HandleFunc("/jobs/", s.handleJob)
+	mux.HandleFunc("/products", s.handleProducts)
+	mux.Handle("/metrics", promhttp.Handler())
+	return mux
+}
+
+type createJobRequest struct {
+	URL  string `json:"url"`
+	Sink string `json:"sink,omitempty"`
+}
+
+func (s *Server) handleJobs(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req createJobRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request body: %v", err), http.StatusBadRequest)
+		return
+	}
+	if req.URL == "" {
+		http.Error(w, "url is required", http.StatusBadRequest)
+		return
+	}
+
+	job := &Job{
+		ID:        uuid.NewString(),
+		URL:       req.URL,
+		SinkSpec:  req.Sink,
+		Status:    StatusPending,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	s.store.put(job)
+
+	go s.runJob(job.ID, job.URL, job.SinkSpec)
+
+	writeJSON(w, http.StatusAccepted, job)
+}
+
+func (s *Server) handleJob(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	id := strings.TrimPrefix(r.URL.Path, "/jobs/")
+	job, ok := s.store.snapshot(id)
+	if !ok {
+		http.Error(w, "job not found", http.StatusNotFound)
+		return
+	}
+
+	pn, ps := paginationParams(r)
+	paged := job
+	paged.Products = paginate(job.Products, pn, ps)
+	writeJSON(w, http.StatusOK, paged)
+}
+
+func (s *Server) handleProducts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	pn, ps := paginationParams(r)
+	writeJSON(w, http.StatusOK, paginate(s.store.lastProducts(), pn, ps))
+}
+
+// runJob executes one job to completion. It only ever touches the shared
+// *Job through store methods that hold store.mu; url and sinkSpec are
+// snapshotted by the caller so this goroutine never reads or writes the
+// shared job struct directly.
+func (s *Server) runJob(jobID, url, sinkSpec string) {
+	jobsInFlight.Inc()
+	defer jobsInFlight.Dec()
+	s.store.markRunning(jobID)
+
+	profile, ok := s.registry.ForURL(url)
+	if !ok {
+		jobsTotal.WithLabelValues("error").Inc()
+		s.store.markFailed(jobID, fmt.Errorf("no site profile registered for %s", url))
+		return
+	}
+
+	start := time.Now()
+	result, err := s.fetchWithRetry(context.Background(), scraper.TargetForProfile(url, profile))
+	fetchLatencySeconds.Observe(time.Since(start).Seconds())
+	if err != nil {
+		jobsTotal.WithLabelValues("error").Inc()
+		s.store.markFailed(jobID, fmt.Errorf("fetch failed: %w", err))
+		return
+	}
+
+	var products []scraper.ProductDetails
+	for _, xhr := range result.XHR {
+		if !strings.Contains(xhr.URL, profile.XHRURLContains) {
+			continue
+		}
+		records, _, err := sitepkg.Extract(profile, xhr.Body, result.HTML)
+		if err != nil {
+			continue
+		}
+		for _, record := range records {
+			products = append(products, scraper.ProductFromRecord(record))
+		}
+		break
+	}
+	productsExtractedTotal.Add(float64(len(products)))
+
+	if sinkSpec != "" {
+		if err := writeToSink(sinkSpec, products); err != nil {
+			jobsTotal.WithLabelValues("error").Inc()
+			s.store.markFailed(jobID, fmt.Errorf("sink write failed: %w", err))
+			return
+		}
+	}
+
+	jobsTotal.WithLabelValues("ok").Inc()
+	s.store.markDone(jobID, products)
+}
+
+// fetchWithRetry retries transient fetch failures with a short fixed
+// backoff, recording each retry on the fetchRetriesTotal counter.
+func (s *Server) fetchWithRetry(ctx context.Context, target scraper.Target) (*scraper.PageResult, error) {
+	const maxRetries = 3
+	const backoff = 500 * time.Millisecond
+
+	var lastErr error
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		if attempt > 0 {
+			fetchRetriesTotal.Inc()
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(backoff):
+			}
+		}
+
+		result, err := s.fetcher.Fetch(ctx, target)
+		if err == nil {
+			return result, nil
+		}
+		lastErr = err
+	}
+
+	return nil, fmt.Errorf("giving up after %d retries: %w", maxRetries, lastErr)
+}
+
+func writeToSink(spec string, products []scraper.ProductDetails) error {
+	sk, err := sink.Parse(spec)
+	if err != nil {
+		return err
+	}
+	for _, product := range products {
+		if err := sk.Write(product); err != nil {
+			sk.Close()
+			return err
+		}
+	}
+	return sk.Close()
+}
+
+// paginationParams reads the "pn" (page number, 1-based) and "ps" (page
+// size) query params, defaulting to the first page of 20.
+func paginationParams(r *http.Request) (pn, ps int) {
+	pn, ps = 1, 20
+	if v, err := strconv.Atoi(r.URL.Query().Get("pn")); err == nil && v > 0 {
+		pn = v
+	}
+	if v, err := strconv.Atoi(r.URL.Query().Get("ps")); err == nil && v > 0 {
+		ps = v
+	}
+	return pn, ps
+}
+
+func paginate[T any](items []T, pn, ps int) []T {
+	start := (pn - 1) * ps
+	if start >= len(items) {
+		return []T{}
+	}
+	end := start + ps
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[start:end]
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
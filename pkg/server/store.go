@@ -0,0 +1,90 @@
+package server
+
+import (
+	"sync"
+	"time"
+
+	"lazuli/pkg/scraper"
+)
+
+// store is an in-memory Job registry. A real deployment would likely swap
+// this for a database-backed store, but the HTTP handlers only depend on
+// this small interface's shape.
+type store struct {
+	mu       sync.RWMutex
+	jobs     map[string]*Job
+	order    []string // insertion order, so /products can find "the last run"
+	lastDone string
+}
+
+func newStore() *store {
+	return &store{jobs: make(map[string]*Job)}
+}
+
+func (s *store) put(job *Job) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, exists := s.jobs[job.ID]; !exists {
+		s.order = append(s.order, job.ID)
+	}
+	s.jobs[job.ID] = job
+}
+
+// snapshot returns a copy of the Job for id, taken while holding the store
+// lock, so callers never read the shared *Job concurrently with a
+// markRunning/markDone/markFailed mutation.
+func (s *store) snapshot(id string) (Job, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	job, ok := s.jobs[id]
+	if !ok {
+		return Job{}, false
+	}
+	return *job, true
+}
+
+func (s *store) markRunning(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.jobs[id]
+	if !ok {
+		return
+	}
+	job.Status = StatusRunning
+	job.UpdatedAt = time.Now()
+}
+
+func (s *store) markDone(id string, products []scraper.ProductDetails) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.jobs[id]
+	if !ok {
+		return
+	}
+	job.Status = StatusDone
+	job.Products = products
+	job.UpdatedAt = time.Now()
+	s.lastDone = id
+}
+
+func (s *store) markFailed(id string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.jobs[id]
+	if !ok {
+		return
+	}
+	job.Status = StatusFailed
+	job.Error = err.Error()
+	job.UpdatedAt = time.Now()
+}
+
+// lastProducts returns the products from the most recently completed job.
+func (s *store) lastProducts() []scraper.ProductDetails {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.lastDone == "" {
+		return nil
+	}
+	return s.jobs[s.lastDone].Products
+}
@@ -0,0 +1,98 @@
+package sink
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"lazuli/pkg/scraper"
+)
+
+// CSVSink writes products to a CSV file, one row per product, with the
+// header written up front. Unlike the ad hoc export this replaces, it
+// carries AvailableSizes in a single column instead of duplicating it.
+type CSVSink struct {
+	file   *os.File
+	writer *csv.Writer
+}
+
+var csvHeader = []string{"ID", "URL", "ProductName", "Category", "Price", "ImageURL", "AvailableSizes", "Description", "Keywords"}
+
+// NewCSVSink creates (or truncates) path and writes the CSV header.
+func NewCSVSink(path string) (*CSVSink, error) {
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+			return nil, fmt.Errorf("sink: failed to create directory for %s: %w", path, err)
+		}
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("sink: failed to create CSV file %s: %w", path, err)
+	}
+
+	writer := csv.NewWriter(file)
+	if err := writer.Write(csvHeader); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("sink: failed to write CSV header: %w", err)
+	}
+
+	return &CSVSink{file: file, writer: writer}, nil
+}
+
+// Write implements Sink.
+func (s *CSVSink) Write(product scraper.ProductDetails) error {
+	id := orDefault(product.ArticleNumber, "N/A")
+
+	// product.Link is expected to already be an absolute URL: profiles
+	// resolve relative links against their own base via a urljoin
+	// transform (see sitepkg.ApplyTransforms), so this sink stays
+	// retailer-agnostic.
+	keywords := []string{}
+	if product.Sport != "" {
+		keywords = append(keywords, product.Sport)
+	}
+	keywords = append(keywords, product.Surface...)
+	if product.Brand != "" {
+		keywords = append(keywords, product.Brand)
+	}
+	if product.Category != "" {
+		keywords = append(keywords, product.Category)
+	}
+
+	row := []string{
+		id,
+		orDefault(product.Link, "N/A"),
+		orDefault(product.Name, "N/A"),
+		orDefault(product.Category, "N/A"),
+		fmt.Sprintf("%.2f", product.Pricing.CurrentPrice),
+		orDefault(product.ImageLink, "N/A"),
+		orDefault(strings.Join(product.Sizes, ", "), "N/A"),
+		orDefault(product.SubTitle, "N/A"),
+		orDefault(strings.Join(keywords, ", "), "N/A"),
+	}
+
+	if err := s.writer.Write(row); err != nil {
+		return fmt.Errorf("sink: failed to write CSV row for %s: %w", id, err)
+	}
+	return nil
+}
+
+// Close flushes buffered rows and closes the file.
+func (s *CSVSink) Close() error {
+	s.writer.Flush()
+	if err := s.writer.Error(); err != nil {
+		s.file.Close()
+		return fmt.Errorf("sink: failed to flush CSV writer: %w", err)
+	}
+	return s.file.Close()
+}
+
+func orDefault(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,54 @@
+package sink
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"lazuli/pkg/scraper"
+)
+
+// NDJSONSink writes one JSON-encoded product per line to an underlying
+// writer. It's the natural choice for streaming output (stdout, a pipe)
+// since each line is independently parseable as soon as it's flushed.
+type NDJSONSink struct {
+	out    io.Writer
+	closer io.Closer // nil for writers the sink doesn't own, e.g. stdout
+}
+
+// NewNDJSONSink writes to out without ever closing it. Use this for
+// writers the caller owns, like os.Stdout.
+func NewNDJSONSink(out io.Writer) *NDJSONSink {
+	return &NDJSONSink{out: out}
+}
+
+// NewNDJSONFileSink creates (or truncates) path and writes NDJSON to it;
+// Close closes the file.
+func NewNDJSONFileSink(path string) (*NDJSONSink, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("sink: failed to create NDJSON file %s: %w", path, err)
+	}
+	return &NDJSONSink{out: file, closer: file}, nil
+}
+
+// Write implements Sink.
+func (s *NDJSONSink) Write(product scraper.ProductDetails) error {
+	line, err := json.Marshal(product)
+	if err != nil {
+		return fmt.Errorf("sink: failed to encode product: %w", err)
+	}
+	if _, err := s.out.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("sink: failed to write NDJSON line: %w", err)
+	}
+	return nil
+}
+
+// Close closes the underlying file, if this sink owns one.
+func (s *NDJSONSink) Close() error {
+	if s.closer == nil {
+		return nil
+	}
+	return s.closer.Close()
+}
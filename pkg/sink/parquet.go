@@ -0,0 +1,75 @@
+package sink
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/parquet-go/parquet-go"
+
+	"lazuli/pkg/scraper"
+)
+
+// parquetRow is the flattened, Parquet-friendly shape of ProductDetails:
+// Parquet has no native notion of ProductDetails' nested ProductPricing,
+// and repeated string columns are awkward to query, so sizes/surface are
+// joined into a single comma-separated string.
+type parquetRow struct {
+	ArticleNumber string  `parquet:"article_number"`
+	Name          string  `parquet:"name"`
+	Category      string  `parquet:"category"`
+	Link          string  `parquet:"link"`
+	ImageLink     string  `parquet:"image_link"`
+	SubTitle      string  `parquet:"sub_title"`
+	Sizes         string  `parquet:"sizes"`
+	Sport         string  `parquet:"sport"`
+	Surface       string  `parquet:"surface"`
+	Brand         string  `parquet:"brand"`
+	CurrentPrice  float64 `parquet:"current_price"`
+}
+
+// ParquetSink writes products to a single Parquet file. Parquet requires
+// knowing the full row set up front to lay out column chunks efficiently,
+// so rows are buffered in memory and written out on Close.
+type ParquetSink struct {
+	path string
+	rows []parquetRow
+}
+
+// NewParquetSink returns a sink that writes to path on Close.
+func NewParquetSink(path string) *ParquetSink {
+	return &ParquetSink{path: path}
+}
+
+// Write implements Sink, buffering product until Close.
+func (s *ParquetSink) Write(product scraper.ProductDetails) error {
+	s.rows = append(s.rows, parquetRow{
+		ArticleNumber: product.ArticleNumber,
+		Name:          product.Name,
+		Category:      product.Category,
+		Link:          product.Link,
+		ImageLink:     product.ImageLink,
+		SubTitle:      product.SubTitle,
+		Sizes:         strings.Join(product.Sizes, ","),
+		Sport:         product.Sport,
+		Surface:       strings.Join(product.Surface, ","),
+		Brand:         product.Brand,
+		CurrentPrice:  product.Pricing.CurrentPrice,
+	})
+	return nil
+}
+
+// Close writes the buffered rows to s.path as a Parquet file.
+func (s *ParquetSink) Close() error {
+	file, err := os.Create(s.path)
+	if err != nil {
+		return fmt.Errorf("sink: failed to create Parquet file %s: %w", s.path, err)
+	}
+	defer file.Close()
+
+	writer := parquet.NewGenericWriter[parquetRow](file)
+	if _, err := writer.Write(s.rows); err != nil {
+		return fmt.Errorf("sink: failed to write Parquet rows: %w", err)
+	}
+	return writer.Close()
+}
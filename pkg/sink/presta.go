@@ -0,0 +1,92 @@
+package sink
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"lazuli/pkg/scraper"
+)
+
+// prestaHeader matches the column order PrestaShop's product CSV importer
+// expects (Catalog > Import). Columns this scraper has no data for (tax
+// rule, EAN13, weight, ...) are left blank so the importer falls back to
+// its own defaults.
+var prestaHeader = []string{
+	"ID", "Active (0/1)", "Name", "Categories (x,y,z...)",
+	"Price tax excluded", "Tax rule ID", "Reference #", "Supplier reference #",
+	"Quantity", "Weight", "Visibility", "Summary", "Description",
+	"Meta title", "Meta keywords", "Meta description", "Rewritten URL",
+	"Image URLs (x,y,z...)",
+}
+
+// PrestaCSVSink writes products as a CSV matching PrestaShop's product
+// import layout, so the output can be fed directly into a PrestaShop store.
+type PrestaCSVSink struct {
+	file   *os.File
+	writer *csv.Writer
+}
+
+// NewPrestaCSVSink creates (or truncates) path and writes the PrestaShop header.
+func NewPrestaCSVSink(path string) (*PrestaCSVSink, error) {
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+			return nil, fmt.Errorf("sink: failed to create directory for %s: %w", path, err)
+		}
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("sink: failed to create PrestaShop CSV file %s: %w", path, err)
+	}
+
+	writer := csv.NewWriter(file)
+	writer.Comma = ';' // PrestaShop's importer expects semicolon-delimited CSV
+	if err := writer.Write(prestaHeader); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("sink: failed to write PrestaShop CSV header: %w", err)
+	}
+
+	return &PrestaCSVSink{file: file, writer: writer}, nil
+}
+
+// Write implements Sink.
+func (s *PrestaCSVSink) Write(product scraper.ProductDetails) error {
+	row := []string{
+		product.ArticleNumber,
+		"1", // Active
+		product.Name,
+		product.Category,
+		fmt.Sprintf("%.2f", product.Pricing.CurrentPrice),
+		"", // Tax rule ID: left to the importer's default
+		product.ArticleNumber,
+		"",
+		"0", // Quantity: unknown at scrape time
+		"",
+		"1", // Visibility: visible everywhere
+		product.SubTitle,
+		product.SubTitle,
+		product.Name,
+		strings.Join(append([]string{product.Sport, product.Brand}, product.Surface...), ","),
+		product.SubTitle,
+		"",
+		product.ImageLink,
+	}
+
+	if err := s.writer.Write(row); err != nil {
+		return fmt.Errorf("sink: failed to write PrestaShop CSV row for %s: %w", product.ArticleNumber, err)
+	}
+	return nil
+}
+
+// Close flushes buffered rows and closes the file.
+func (s *PrestaCSVSink) Close() error {
+	s.writer.Flush()
+	if err := s.writer.Error(); err != nil {
+		s.file.Close()
+		return fmt.Errorf("sink: failed to flush PrestaShop CSV writer: %w", err)
+	}
+	return s.file.Close()
+}
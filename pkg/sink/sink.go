@@ -0,0 +1,47 @@
+// Package sink defines where extracted products go once they're scraped:
+// a CSV or NDJSON file, a Parquet file, a SQL table, or a PrestaShop import
+// CSV. A run can fan out to several sinks at once via Multi.
+package sink
+
+import "lazuli/pkg/scraper"
+
+// Sink persists one product at a time as a scrape or crawl progresses.
+// Close flushes any buffered state and releases the underlying resource
+// (file handle, DB connection, ...).
+type Sink interface {
+	Write(product scraper.ProductDetails) error
+	Close() error
+}
+
+// Multi fans writes out to several sinks at once, e.g. NDJSON to stdout
+// while also loading a SQL table.
+type Multi struct {
+	sinks []Sink
+}
+
+// NewMulti wraps sinks into a single Sink that writes to all of them.
+func NewMulti(sinks ...Sink) *Multi {
+	return &Multi{sinks: sinks}
+}
+
+// Write implements Sink, stopping at the first sink that errors.
+func (m *Multi) Write(product scraper.ProductDetails) error {
+	for _, s := range m.sinks {
+		if err := s.Write(product); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close closes every underlying sink, returning the first error
+// encountered (after still attempting to close the rest).
+func (m *Multi) Close() error {
+	var firstErr error
+	for _, s := range m.sinks {
+		if err := s.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
@@ -0,0 +1,102 @@
+package sitepkg
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/antchfx/htmlquery"
+)
+
+// Record is a single extracted item (a product or a breadcrumb) keyed by
+// the field names declared in a Profile.
+type Record map[string]interface{}
+
+// Extract pulls product and breadcrumb records out of xhrBody using
+// profile's JSON paths, falling back to CSS/XPath selectors against html
+// for any field a JSON path didn't resolve.
+func Extract(profile *Profile, xhrBody, html string) (products []Record, breadcrumbs []Record, err error) {
+	var root interface{}
+	if err := json.Unmarshal([]byte(xhrBody), &root); err != nil {
+		return nil, nil, fmt.Errorf("sitepkg: failed to unmarshal XHR body: %w", err)
+	}
+
+	var doc *goquery.Document
+	if html != "" {
+		doc, err = goquery.NewDocumentFromReader(strings.NewReader(html))
+		if err != nil {
+			return nil, nil, fmt.Errorf("sitepkg: failed to parse HTML fallback: %w", err)
+		}
+	}
+
+	items, err := GetList(root, profile.ListPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, item := range items {
+		record, err := extractRecord(item, profile.Fields, doc, html)
+		if err != nil {
+			return nil, nil, err
+		}
+		products = append(products, record)
+	}
+
+	if profile.BreadcrumbPath != "" {
+		crumbs, err := GetList(root, profile.BreadcrumbPath)
+		if err != nil {
+			return products, nil, nil // breadcrumbs are best-effort
+		}
+		for _, item := range crumbs {
+			record, err := extractRecord(item, profile.BreadcrumbFields, doc, html)
+			if err != nil {
+				return products, nil, err
+			}
+			breadcrumbs = append(breadcrumbs, record)
+		}
+	}
+
+	return products, breadcrumbs, nil
+}
+
+func extractRecord(item interface{}, fields map[string]FieldSpec, doc *goquery.Document, html string) (Record, error) {
+	record := make(Record, len(fields))
+	for name, spec := range fields {
+		value, ok := GetPath(item, spec.JSONPath)
+		if !ok {
+			value, ok = fallbackFromHTML(spec, doc, html)
+		}
+		if !ok {
+			continue
+		}
+
+		transformed, err := ApplyTransforms(value, spec.Transforms)
+		if err != nil {
+			return nil, fmt.Errorf("sitepkg: field %q: %w", name, err)
+		}
+		record[name] = transformed
+	}
+	return record, nil
+}
+
+// fallbackFromHTML tries a field's CSS selector, then its XPath expression,
+// against the rendered page. Used when a field isn't present in the XHR
+// payload at all (e.g. a value only rendered server-side).
+func fallbackFromHTML(spec FieldSpec, doc *goquery.Document, html string) (interface{}, bool) {
+	if doc != nil && spec.CSSSelector != "" {
+		if text := strings.TrimSpace(doc.Find(spec.CSSSelector).First().Text()); text != "" {
+			return text, true
+		}
+	}
+
+	if html != "" && spec.XPath != "" {
+		node, err := htmlquery.Parse(strings.NewReader(html))
+		if err == nil {
+			if found := htmlquery.FindOne(node, spec.XPath); found != nil {
+				return strings.TrimSpace(htmlquery.InnerText(found)), true
+			}
+		}
+	}
+
+	return nil, false
+}
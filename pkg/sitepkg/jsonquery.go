@@ -0,0 +1,65 @@
+package sitepkg
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// GetPath walks root along a dot/bracket path in the spirit of jsonq, e.g.
+// "recommendations.0.pricing.currentPrice" or "recommendations[0].name".
+// It works against the generic interface{} tree produced by
+// encoding/json.Unmarshal into an interface{}, so no struct tags are needed.
+func GetPath(root interface{}, path string) (interface{}, bool) {
+	if path == "" {
+		return root, true
+	}
+
+	current := root
+	for _, segment := range splitPath(path) {
+		if segment == "" {
+			continue
+		}
+
+		if index, err := strconv.Atoi(segment); err == nil {
+			arr, ok := current.([]interface{})
+			if !ok || index < 0 || index >= len(arr) {
+				return nil, false
+			}
+			current = arr[index]
+			continue
+		}
+
+		obj, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		value, ok := obj[segment]
+		if !ok {
+			return nil, false
+		}
+		current = value
+	}
+
+	return current, true
+}
+
+// GetList resolves path to a []interface{}, the shape ListPath and
+// BreadcrumbPath are expected to point at.
+func GetList(root interface{}, path string) ([]interface{}, error) {
+	value, ok := GetPath(root, path)
+	if !ok {
+		return nil, fmt.Errorf("sitepkg: path %q not found", path)
+	}
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("sitepkg: path %q did not resolve to a list", path)
+	}
+	return list, nil
+}
+
+// splitPath normalizes "a[0].b" and "a.0.b" into the same ["a", "0", "b"].
+func splitPath(path string) []string {
+	replaced := strings.NewReplacer("[", ".", "]", "").Replace(path)
+	return strings.Split(replaced, ".")
+}
@@ -0,0 +1,38 @@
+package sitepkg
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// NextPageURL resolves profile's NextPageSelector against html and returns
+// the absolute URL of the next page, relative to pageURL. It returns false
+// if the profile has no pagination selector or the selector isn't found.
+func NextPageURL(profile *Profile, html, pageURL string) (string, bool) {
+	if profile.NextPageSelector == "" || html == "" {
+		return "", false
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		return "", false
+	}
+
+	href, ok := doc.Find(profile.NextPageSelector).First().Attr("href")
+	if !ok || href == "" {
+		return "", false
+	}
+
+	base, err := url.Parse(pageURL)
+	if err != nil {
+		return href, true
+	}
+	ref, err := url.Parse(href)
+	if err != nil {
+		return href, true
+	}
+
+	return base.ResolveReference(ref).String(), true
+}
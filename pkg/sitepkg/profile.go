@@ -0,0 +1,124 @@
+// Package sitepkg turns the scraper from a one-site script into a generic
+// e-commerce catalog extractor. A Profile describes, per domain, where the
+// product payload lives and how to pull each field out of it, so adding a
+// new retailer is a data change rather than a code change.
+package sitepkg
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FieldSpec describes how to pull a single field out of a product record.
+// JSONPath is tried first; CSSSelector/XPath are fallbacks evaluated against
+// the rendered HTML when the XHR payload doesn't carry the field. Transforms
+// are applied, in order, to whichever value is found.
+type FieldSpec struct {
+	JSONPath    string   `json:"jsonPath,omitempty" yaml:"jsonPath,omitempty"`
+	CSSSelector string   `json:"cssSelector,omitempty" yaml:"cssSelector,omitempty"`
+	XPath       string   `json:"xpath,omitempty" yaml:"xpath,omitempty"`
+	Transforms  []string `json:"transforms,omitempty" yaml:"transforms,omitempty"`
+}
+
+// Profile describes how to extract products for one retailer.
+type Profile struct {
+	// Domain is matched as a substring against the target URL's host.
+	Domain string `json:"domain" yaml:"domain"`
+	// XHRURLContains selects which captured XHR holds the product payload.
+	XHRURLContains string `json:"xhrUrlContains" yaml:"xhrUrlContains"`
+	// ListPath is the JSON path (see jsonquery.go) to the array of product
+	// records within the matched XHR body.
+	ListPath string `json:"listPath" yaml:"listPath"`
+	// BreadcrumbPath is the JSON path to the array of breadcrumb records.
+	BreadcrumbPath string `json:"breadcrumbPath,omitempty" yaml:"breadcrumbPath,omitempty"`
+	// Fields maps a ProductDetails field name (e.g. "articleNumber") to
+	// where and how it's found in a record.
+	Fields map[string]FieldSpec `json:"fields" yaml:"fields"`
+	// BreadcrumbFields maps a Breadcrumb field name (text, link, type) the
+	// same way Fields does for products.
+	BreadcrumbFields map[string]FieldSpec `json:"breadcrumbFields,omitempty" yaml:"breadcrumbFields,omitempty"`
+	// NextPageSelector is a CSS selector, evaluated against the rendered
+	// HTML, whose href points at the next page of results. Left empty for
+	// sites with no pagination.
+	NextPageSelector string `json:"nextPageSelector,omitempty" yaml:"nextPageSelector,omitempty"`
+	// WaitForSelector, if set, blocks a browser-backed Fetcher until the
+	// selector appears before the page is considered ready (e.g. a
+	// lazy-loaded product grid).
+	WaitForSelector string `json:"waitForSelector,omitempty" yaml:"waitForSelector,omitempty"`
+	// ClickSelector, if set, is clicked once the page loads (e.g. to
+	// dismiss a cookie banner or open an infinite-scroll section).
+	ClickSelector string `json:"clickSelector,omitempty" yaml:"clickSelector,omitempty"`
+	// FillSelector/FillValue, if both set, fill an input before continuing
+	// (e.g. a region or postcode gate).
+	FillSelector string `json:"fillSelector,omitempty" yaml:"fillSelector,omitempty"`
+	FillValue    string `json:"fillValue,omitempty" yaml:"fillValue,omitempty"`
+	// Cookies are attached to the request/session before navigation.
+	Cookies []CookieSpec `json:"cookies,omitempty" yaml:"cookies,omitempty"`
+}
+
+// CookieSpec is a single cookie to attach to a request, as declared in a
+// site profile.
+type CookieSpec struct {
+	Name  string `json:"name" yaml:"name"`
+	Value string `json:"value" yaml:"value"`
+}
+
+// LoadProfile reads a single site profile from path. YAML is used for
+// .yaml/.yml files, JSON otherwise.
+func LoadProfile(path string) (*Profile, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("sitepkg: failed to read profile %s: %w", path, err)
+	}
+
+	var profile Profile
+	ext := strings.ToLower(filepath.Ext(path))
+	switch ext {
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(raw, &profile); err != nil {
+			return nil, fmt.Errorf("sitepkg: failed to parse YAML profile %s: %w", path, err)
+		}
+	default:
+		if err := json.Unmarshal(raw, &profile); err != nil {
+			return nil, fmt.Errorf("sitepkg: failed to parse JSON profile %s: %w", path, err)
+		}
+	}
+
+	if profile.Domain == "" {
+		return nil, fmt.Errorf("sitepkg: profile %s is missing a domain", path)
+	}
+
+	return &profile, nil
+}
+
+// LoadProfileDir reads every .json/.yaml/.yml file in dir as a Profile,
+// keyed by its Domain.
+func LoadProfileDir(dir string) (map[string]*Profile, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("sitepkg: failed to read profile directory %s: %w", dir, err)
+	}
+
+	profiles := make(map[string]*Profile)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if ext != ".json" && ext != ".yaml" && ext != ".yml" {
+			continue
+		}
+		profile, err := LoadProfile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		profiles[profile.Domain] = profile
+	}
+
+	return profiles, nil
+}
@@ -0,0 +1,86 @@
+package sitepkg
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+//go:embed profiles/*.json
+var builtinProfilesFS embed.FS
+
+// Registry resolves a target URL to the Profile that knows how to extract
+// products from it.
+type Registry struct {
+	profiles map[string]*Profile
+}
+
+// NewRegistry returns a Registry pre-loaded with the profiles shipped in
+// pkg/sitepkg/profiles.
+func NewRegistry() (*Registry, error) {
+	registry := &Registry{profiles: make(map[string]*Profile)}
+
+	entries, err := builtinProfilesFS.ReadDir("profiles")
+	if err != nil {
+		return nil, fmt.Errorf("sitepkg: failed to read builtin profiles: %w", err)
+	}
+
+	for _, entry := range entries {
+		raw, err := builtinProfilesFS.ReadFile("profiles/" + entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("sitepkg: failed to read builtin profile %s: %w", entry.Name(), err)
+		}
+		var profile Profile
+		if err := json.Unmarshal(raw, &profile); err != nil {
+			return nil, fmt.Errorf("sitepkg: failed to parse builtin profile %s: %w", entry.Name(), err)
+		}
+		registry.profiles[profile.Domain] = &profile
+	}
+
+	return registry, nil
+}
+
+// Add registers or overrides a profile, keyed by its Domain.
+func (r *Registry) Add(profile *Profile) {
+	r.profiles[profile.Domain] = profile
+}
+
+// AddDir loads every profile in dir and registers it, overriding any
+// builtin profile with the same domain.
+func (r *Registry) AddDir(dir string) error {
+	profiles, err := LoadProfileDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, profile := range profiles {
+		r.Add(profile)
+	}
+	return nil
+}
+
+// ForURL returns the profile whose Domain matches targetURL's host, if any.
+// A match is an exact host match or a proper subdomain of Domain (so
+// "adidas.jp" matches "shop.adidas.jp" but not "adidas.jp.evil.example.com").
+// If more than one profile matches, the most specific (longest) Domain wins,
+// independent of map iteration order.
+func (r *Registry) ForURL(targetURL string) (*Profile, bool) {
+	parsed, err := url.Parse(targetURL)
+	if err != nil {
+		return nil, false
+	}
+	host := parsed.Hostname()
+
+	var best *Profile
+	for domain, profile := range r.profiles {
+		if host != domain && !strings.HasSuffix(host, "."+domain) {
+			continue
+		}
+		if best == nil || len(domain) > len(best.Domain) {
+			best = profile
+		}
+	}
+
+	return best, best != nil
+}
@@ -0,0 +1,76 @@
+package sitepkg
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ApplyTransforms runs value through each named transform in order. A
+// transform is either a bare name ("trim", "price") or "name:arg"
+// ("regex:[0-9]+", "urljoin:https://shop.adidas.jp").
+func ApplyTransforms(value interface{}, transforms []string) (interface{}, error) {
+	for _, spec := range transforms {
+		name, arg, _ := strings.Cut(spec, ":")
+
+		str := fmt.Sprintf("%v", value)
+		switch name {
+		case "trim":
+			value = strings.TrimSpace(str)
+		case "regex":
+			re, err := regexp.Compile(arg)
+			if err != nil {
+				return nil, fmt.Errorf("sitepkg: invalid regex transform %q: %w", arg, err)
+			}
+			value = re.FindString(str)
+		case "price":
+			parsed, err := parsePrice(str)
+			if err != nil {
+				return nil, fmt.Errorf("sitepkg: price transform failed on %q: %w", str, err)
+			}
+			value = parsed
+		case "urljoin":
+			joined, err := joinURL(arg, str)
+			if err != nil {
+				return nil, fmt.Errorf("sitepkg: urljoin transform failed on %q: %w", str, err)
+			}
+			value = joined
+		default:
+			return nil, fmt.Errorf("sitepkg: unknown transform %q", name)
+		}
+	}
+
+	return value, nil
+}
+
+// parsePrice strips everything but digits and a decimal point, so values
+// like "¥12,800" or "$128.00" both parse to a float64.
+func parsePrice(raw string) (float64, error) {
+	var cleaned strings.Builder
+	for _, r := range raw {
+		if (r >= '0' && r <= '9') || r == '.' {
+			cleaned.WriteRune(r)
+		}
+	}
+	if cleaned.Len() == 0 {
+		return 0, fmt.Errorf("no digits found")
+	}
+	return strconv.ParseFloat(cleaned.String(), 64)
+}
+
+func joinURL(base, ref string) (string, error) {
+	if strings.HasPrefix(ref, "http://") || strings.HasPrefix(ref, "https://") {
+		return ref, nil
+	}
+	baseURL, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+	refURL, err := url.Parse(ref)
+	if err != nil {
+		return "", err
+	}
+	return baseURL.ResolveReference(refURL).String(), nil
+}